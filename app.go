@@ -0,0 +1,168 @@
+package gcli
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// App is the top-level command dispatcher: it owns a registry of
+// Commands and is responsible for looking up and running the one the
+// user asked for.
+type App struct {
+	// Name is the binary name, used in help output and completion scripts.
+	Name string
+	// Strict rejects extra positional arguments beyond what a command
+	// declares, instead of silently ignoring them.
+	Strict bool
+	// Suggest enables "Did you mean ...?" hints for unknown commands,
+	// inherited by every Command registered on this app.
+	Suggest bool
+	// EnableBashCompletion turns on the hidden --generate-bash-completion
+	// sentinel handling in Run. Set by gcli/completion.Enable, which also
+	// wires CompletionFunc.
+	EnableBashCompletion bool
+	// CompletionFunc supplies candidate completions for the
+	// --generate-bash-completion sentinel, given the already-entered
+	// words (priorArgs) and the word currently being completed (cur).
+	// Normally set by gcli/completion.Enable rather than directly.
+	CompletionFunc func(priorArgs []string, cur string) []string
+	// HelpWriter is where ShowHelp writes rendered help text. Defaults to
+	// os.Stdout when nil.
+	HelpWriter io.Writer
+
+	commands map[string]*Command
+	names    []string // insertion-ordered primary command names
+	errs     []error
+}
+
+// NewApp creates an application named name.
+func NewApp(name string) *App {
+	return &App{Name: name, commands: make(map[string]*Command)}
+}
+
+// AddError records an error, eg reported by a Command running under this
+// app, for later inspection.
+func (a *App) AddError(err error) { a.errs = append(a.errs, err) }
+
+// Errors returns all errors recorded via AddError.
+func (a *App) Errors() []error { return a.errs }
+
+// AddCommand registers c as one of the app's top-level commands.
+func (a *App) AddCommand(c *Command) *App {
+	c.alone = false
+	c.app = a
+
+	a.names = append(a.names, c.Name)
+	a.commands[c.Name] = c
+	for _, alias := range c.Aliases {
+		a.commands[alias] = c
+	}
+	return a
+}
+
+// Commands returns the app's registered top-level commands, in
+// registration order.
+func (a *App) Commands() []*Command {
+	cmds := make([]*Command, 0, len(a.names))
+	for _, name := range a.names {
+		cmds = append(cmds, a.commands[name])
+	}
+	return cmds
+}
+
+// CommandNames returns every dispatchable name (primary names and
+// aliases) across the app's registered commands.
+func (a *App) CommandNames() (names []string) {
+	for _, c := range a.Commands() {
+		names = append(names, c.Name)
+		names = append(names, c.Aliases...)
+	}
+	return
+}
+
+// generateBashCompletionFlag is the hidden sentinel a completion script
+// appends to ask the binary itself for completion candidates, see
+// gcli/completion.
+const generateBashCompletionFlag = "--generate-bash-completion"
+
+// Run looks up the command named by args[0], parses its flags from the
+// remaining args, and executes it with what's left. "-h"/"--help" as
+// args[0] shows the app-level help instead of dispatching. If
+// EnableBashCompletion is set and args ends with the hidden
+// --generate-bash-completion sentinel, Run prints completion candidates
+// via CompletionFunc instead of dispatching a command.
+func (a *App) Run(args []string) error {
+	if a.EnableBashCompletion && len(args) > 0 && args[len(args)-1] == generateBashCompletionFlag {
+		prior := args[:len(args)-1]
+		var cur string
+		if len(prior) > 0 {
+			cur = prior[len(prior)-1]
+			prior = prior[:len(prior)-1]
+		}
+
+		if a.CompletionFunc != nil {
+			for _, candidate := range a.CompletionFunc(prior, cur) {
+				fmt.Println(candidate)
+			}
+		}
+		return nil
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("must specify a command to run")
+	}
+
+	name, rest := args[0], args[1:]
+
+	if name == "-h" || name == "--help" {
+		a.ShowHelp()
+		return nil
+	}
+
+	cmd, ok := a.commands[name]
+	if !ok {
+		errMsg := fmt.Sprintf("unknown command: %q", name)
+		if a.Suggest {
+			if msg := suggestMsg(name, a.CommandNames()); msg != "" {
+				errMsg += "\n" + msg
+			}
+		}
+		return fmt.Errorf("%s", errMsg)
+	}
+
+	parsedArgs, err := cmd.parseFlags(rest)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Execute(parsedArgs)
+}
+
+// AppHelpTemplate is the template rendered by App.ShowHelp: global
+// options followed by every registered command, grouped by Category.
+// See CommandHelpTemplate for the per-command equivalent.
+var AppHelpTemplate = `<comment>Usage:</> {{.Name}} [Global Options...] <info>{command}</> [--option ...] [argument ...]
+
+<comment>Global Options:</>
+      <info>--verbose</>     Set error reporting level(quiet 0 - 4 debug)
+      <info>--no-color</>    Disable color when outputting message
+  <info>-h, --help</>        Display this help information
+{{range $cat := .Categories}}
+<comment>{{$cat.Name}}:</>{{range $c := $cat.Commands}}
+  <info>{{$c.Name | printf "%-12s"}}</>{{$c.Description}}{{end}}
+{{end}}`
+
+// ShowHelp prints the app-level help: global options, then every
+// registered command grouped by Category (see CommandCategories).
+func (a *App) ShowHelp() {
+	w := a.HelpWriter
+	if w == nil {
+		w = os.Stdout
+	}
+
+	HelpPrinter(w, AppHelpTemplate, map[string]interface{}{
+		"Name":       a.Name,
+		"Categories": CommandCategories(a.Commands()),
+	})
+}
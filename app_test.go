@@ -0,0 +1,121 @@
+package gcli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestApp_Run_SuggestsUnknownCommand(t *testing.T) {
+	app := NewApp("myapp")
+	app.Suggest = true
+	app.AddCommand(NewCommand("install", "install something", func(c *Command, args []string) error {
+		return nil
+	}))
+
+	err := app.Run([]string{"instal"})
+	if err == nil || !strings.Contains(err.Error(), "Did you mean") {
+		t.Fatalf("Run(instal) error = %v, want it to suggest 'install'", err)
+	}
+}
+
+func TestApp_Run_NoSuggestionWhenDisabled(t *testing.T) {
+	app := NewApp("myapp")
+	app.AddCommand(NewCommand("install", "install something", func(c *Command, args []string) error {
+		return nil
+	}))
+
+	err := app.Run([]string{"instal"})
+	if err == nil || strings.Contains(err.Error(), "Did you mean") {
+		t.Fatalf("Run(instal) error = %v, want no suggestion when App.Suggest is unset", err)
+	}
+}
+
+func TestApp_Run_GenerateBashCompletionSentinel(t *testing.T) {
+	app := NewApp("myapp")
+	app.EnableBashCompletion = true
+
+	var gotPrior []string
+	var gotCur string
+	app.CompletionFunc = func(prior []string, cur string) []string {
+		gotPrior, gotCur = prior, cur
+		return []string{"install"}
+	}
+
+	if err := app.Run([]string{"inst", generateBashCompletionFlag}); err != nil {
+		t.Fatalf("Run() error = %v, want nil", err)
+	}
+	if gotCur != "inst" || len(gotPrior) != 0 {
+		t.Fatalf("CompletionFunc called with prior=%v cur=%q, want prior=[] cur=inst", gotPrior, gotCur)
+	}
+}
+
+func TestApp_Run_ParsesCommandFlags(t *testing.T) {
+	app := NewApp("myapp")
+
+	var gotName string
+	var gotArgs []string
+	greet := NewCommand("greet", "say hello", func(c *Command, args []string) error {
+		gotName = c.Flags.Lookup("name").Value.String()
+		gotArgs = args
+		return nil
+	})
+	greet.Flags.String("name", "world", "who to greet")
+	app.AddCommand(greet)
+
+	if err := app.Run([]string{"greet", "--name", "alice", "bob"}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if gotName != "alice" {
+		t.Fatalf("--name = %q, want alice (App.Run must parse cmd.Flags before Execute)", gotName)
+	}
+	if len(gotArgs) != 1 || gotArgs[0] != "bob" {
+		t.Fatalf("positional args = %v, want [bob]", gotArgs)
+	}
+}
+
+func TestApp_ShowHelp_GroupsByCategory(t *testing.T) {
+	var buf bytes.Buffer
+	app := NewApp("myapp")
+	app.HelpWriter = &buf
+	app.AddCommand(&Command{Name: "build", Description: "build the project", Category: "Dev"})
+	app.AddCommand(&Command{Name: "deploy", Description: "deploy the project", Category: "Ops"})
+
+	app.ShowHelp()
+
+	out := buf.String()
+	if !strings.Contains(out, "Dev") || !strings.Contains(out, "build") {
+		t.Fatalf("ShowHelp() = %q, want it to list build under Dev", out)
+	}
+	if !strings.Contains(out, "Ops") || !strings.Contains(out, "deploy") {
+		t.Fatalf("ShowHelp() = %q, want it to list deploy under Ops", out)
+	}
+}
+
+func TestApp_Run_Help(t *testing.T) {
+	var buf bytes.Buffer
+	app := NewApp("myapp")
+	app.HelpWriter = &buf
+	app.AddCommand(NewCommand("install", "install something", func(c *Command, args []string) error {
+		return nil
+	}))
+
+	if err := app.Run([]string{"--help"}); err != nil {
+		t.Fatalf("Run(--help) error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "install") {
+		t.Fatalf("Run(--help) output = %q, want it to list install", buf.String())
+	}
+}
+
+func TestCommand_Execute_SuggestsUnknownSubcommand(t *testing.T) {
+	parent := &Command{Name: "remote", Suggest: true}
+	parent.AddCommand(NewCommand("add", "add a remote", func(c *Command, args []string) error {
+		return nil
+	}))
+
+	err := parent.Execute([]string{"ad"})
+	if err == nil || !strings.Contains(err.Error(), "Did you mean") {
+		t.Fatalf("Execute(ad) error = %v, want it to suggest 'add'", err)
+	}
+}
@@ -0,0 +1,45 @@
+package gcli
+
+// CommandCategory groups commands that share a Category for display in
+// help output.
+type CommandCategory struct {
+	Name     string
+	Commands []*Command
+}
+
+// defaultCategory is the heading used for commands with no Category set.
+const defaultCategory = "Commands"
+
+// CommandCategories groups cmds by their Category field for grouped help
+// output. Uncategorized commands (Category == "") fall under the default
+// "Commands" heading. Hidden commands are omitted entirely. Categories,
+// and the commands within each, are returned in first-seen order.
+func CommandCategories(cmds []*Command) []*CommandCategory {
+	var order []string
+	byName := make(map[string]*CommandCategory)
+
+	for _, c := range cmds {
+		if c.Hidden {
+			continue
+		}
+
+		name := c.Category
+		if name == "" {
+			name = defaultCategory
+		}
+
+		cat, ok := byName[name]
+		if !ok {
+			cat = &CommandCategory{Name: name}
+			byName[name] = cat
+			order = append(order, name)
+		}
+		cat.Commands = append(cat.Commands, c)
+	}
+
+	cats := make([]*CommandCategory, len(order))
+	for i, name := range order {
+		cats[i] = byName[name]
+	}
+	return cats
+}
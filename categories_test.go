@@ -0,0 +1,35 @@
+package gcli
+
+import "testing"
+
+func TestCommandCategories(t *testing.T) {
+	cmds := []*Command{
+		{Name: "build", Category: "Dev"},
+		{Name: "test", Category: "Dev"},
+		{Name: "deploy", Category: "Ops"},
+		{Name: "version"},
+		{Name: "debug-internal", Hidden: true},
+	}
+
+	cats := CommandCategories(cmds)
+	if len(cats) != 3 {
+		t.Fatalf("got %d categories, want 3", len(cats))
+	}
+
+	if cats[0].Name != "Dev" || len(cats[0].Commands) != 2 {
+		t.Errorf("cats[0] = %+v, want Dev with 2 commands", cats[0])
+	}
+	if cats[1].Name != "Ops" || len(cats[1].Commands) != 1 {
+		t.Errorf("cats[1] = %+v, want Ops with 1 command", cats[1])
+	}
+	if cats[2].Name != defaultCategory {
+		t.Errorf("cats[2].Name = %q, want %q", cats[2].Name, defaultCategory)
+	}
+	for _, cat := range cats {
+		for _, c := range cat.Commands {
+			if c.Hidden {
+				t.Errorf("hidden command %q leaked into categories", c.Name)
+			}
+		}
+	}
+}
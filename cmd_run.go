@@ -1,14 +1,17 @@
 package gcli
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"reflect"
 	"strings"
 
 	"github.com/gookit/color"
+	"github.com/gookit/gcli/flags"
 	"github.com/gookit/gcli/helper"
 	"github.com/gookit/goutil/strutil"
 )
@@ -19,12 +22,32 @@ import (
 
 // Execute do execute the command
 func (c *Command) Execute(args []string) (err error) {
+	// a nil Func means this command is just a group: dispatch the first
+	// argument to a registered subcommand instead of running anything.
+	if c.Func == nil && len(c.subs) > 0 && len(args) > 0 {
+		sub := c.findSubcommand(args[0])
+		if sub == nil {
+			errMsg := fmt.Sprintf("unknown subcommand: %q", args[0])
+			if c.suggestEnabled() {
+				if msg := suggestMsg(args[0], c.subcommandNames()); msg != "" {
+					errMsg += "\n" + msg
+				}
+			}
+			return fmt.Errorf("%s", errMsg)
+		}
+		return sub.Execute(args[1:])
+	}
+
 	// collect named args
 	if err := c.collectNamedArgs(args); err != nil {
 		return err
 	}
 
-	c.Fire(EvtBefore, args)
+	// a Before handler returning an error aborts the run: Func is skipped
+	// entirely and EvtError fires with that error.
+	if befErr := c.Fire(EvtBefore, args); befErr != nil {
+		return errors.Join(befErr, c.Fire(EvtError, befErr))
+	}
 
 	// call command handler func
 	if c.Func == nil {
@@ -40,11 +63,10 @@ func (c *Command) Execute(args []string) (err error) {
 			c.app.AddError(err)
 		}
 
-		c.Fire(EvtError, err)
-	} else {
-		c.Fire(EvtAfter, nil)
+		return errors.Join(err, c.Fire(EvtError, err))
 	}
-	return
+
+	return c.Fire(EvtAfter, nil)
 }
 
 func (c *Command) collectNamedArgs(inArgs []string) error {
@@ -74,11 +96,12 @@ func (c *Command) collectNamedArgs(inArgs []string) error {
 	return nil
 }
 
-// Fire event handler by name
-func (c *Command) Fire(event string, data interface{}) {
+// Fire event handler by name. Returns the aggregated error from all
+// handlers bound to the event, or nil if none returned an error.
+func (c *Command) Fire(event string, data interface{}) error {
 	Logf(VerbDebug, "[Cmd.Fire] command '%s' trigger the event: %s", c.Name, event)
 
-	c.SimpleHooks.Fire(event, c, data)
+	return c.SimpleHooks.Fire(event, c, data)
 }
 
 // On add hook handler for a hook event
@@ -116,31 +139,49 @@ func (c *Command) Run(inArgs []string) error {
 		// don't display date on print log
 		log.SetFlags(0)
 
-		// init the command
-		c.initialize()
-
 		// check input args
 		if len(inArgs) == 0 {
 			inArgs = os.Args[1:]
 		}
 
-		// parse args and opts
-		if err := c.Flags.Parse(inArgs); err != nil {
+		rest, err := c.parseFlags(inArgs)
+		if err != nil {
 			exitWithErr(err.Error())
 		}
-
-		inArgs = c.Flags.Args()
+		inArgs = rest
 	}
 
 	return c.Execute(inArgs)
 }
 
+// parseFlags initializes c.Flags and parses inArgs, returning the
+// remaining non-flag arguments. On a flag-parse error it appends a
+// suggestion (if enabled) and returns the error for the caller to
+// handle - eg App.Run reports it, standalone Run exits with it.
+func (c *Command) parseFlags(inArgs []string) ([]string, error) {
+	c.initialize()
+
+	if err := c.Flags.Parse(inArgs); err != nil {
+		errMsg := err.Error()
+		if c.suggestEnabled() {
+			if msg := suggestMsg(unknownFlagToken(errMsg), c.longFlagNames()); msg != "" {
+				errMsg = errMsg + "\n" + msg
+			}
+		}
+		return nil, fmt.Errorf("%s", errMsg)
+	}
+
+	return c.Flags.Args(), nil
+}
+
 /*************************************************************
  * display cmd help
  *************************************************************/
 
-// help template for a command
-var commandHelp = `{{.UseFor}}
+// CommandHelpTemplate help template for a command. Overridable by callers
+// that want a different help layout - eg plain text, or a Markdown
+// man-page style render for `gcli docs` style generators.
+var CommandHelpTemplate = `{{.UseFor}}
 {{if .Cmd.NotAlone}}
 <comment>Name:</> {{.Cmd.Name}}{{if .Cmd.Aliases}} (alias: <info>{{.Cmd.AliasesString}}</>){{end}}{{end}}
 <comment>Usage:</> {$binName} [Global Options...] {{if .Cmd.NotAlone}}<info>{{.Cmd.Name}}</> {{end}}[--option ...] [argument ...]
@@ -151,7 +192,11 @@ var commandHelp = `{{.UseFor}}
   <info>-h, --help</>        Display this help information{{if .Options}}
 
 <comment>Options:</>
-{{.Options}}{{end}}{{if .Cmd.Args}}
+{{.Options}}{{end}}{{if .Categories}}
+{{range $cat := .Categories}}
+<comment>{{$cat.Name}}:</>{{range $c := $cat.Commands}}
+  <info>{{$c.Name | printf "%-12s"}}</>{{$c.Description}}{{end}}
+{{end}}{{end}}{{if .Cmd.Args}}
 
 <comment>Arguments:</>{{range $a := .Cmd.Args}}
   <info>{{$a.Name | printf "%-12s"}}</>{{$a.Description | ucFirst}}{{if $a.Required}}<red>*</>{{end}}{{end}}
@@ -161,23 +206,98 @@ var commandHelp = `{{.UseFor}}
 {{if .Cmd.Help}}<comment>Help:</>
 {{.Cmd.Help}}{{end}}`
 
+// HelpPrinter renders tmpl with data and writes the result to w. The
+// default implementation color-tags the template, renders it through
+// helper.RenderText, expands any `{$binName}`-style help vars on the
+// command passed as data["Cmd"], then colors and writes the result.
+// Override it to plug in a different template engine or output format
+// (eg plain text, JSON for shell completions).
+var HelpPrinter = func(w io.Writer, tmpl string, data interface{}) {
+	s := helper.RenderText(color.ReplaceTag(tmpl), data, nil)
+
+	if dm, ok := data.(map[string]interface{}); ok {
+		if c, ok := dm["Cmd"].(*Command); ok {
+			s = c.ReplaceVars(s)
+		}
+	}
+
+	fmt.Fprint(w, color.String(s))
+}
+
+// FlagStringer formats a single flag.Flag's value type, usage text, and
+// default value for display in command help. Override it to change how
+// that part is rendered - eg to strip color tags for plain-text output.
+//
+// Option-name formatting (long/short form, registered shortcuts) isn't
+// part of this hook: it needs Command context to resolve shortcuts, so
+// ParseDefaults resolves and prepends it itself rather than widening this
+// signature beyond the documented func(*flag.Flag) string contract.
+var FlagStringer = func(fg *flag.Flag) string {
+	var s string
+
+	name, usage := flag.UnquoteUsage(fg)
+	if name == "value" {
+		// flag.UnquoteUsage falls back to "value" for types it doesn't
+		// recognise; give our gcli/flags types a friendlier label.
+		name = typeNameOf(fg.Value)
+	}
+	// option value type
+	if len(name) > 0 {
+		s += fmt.Sprintf(" <magenta>%s</>", name)
+	}
+
+	s += strings.Replace(strutil.UpperFirst(usage), "\n", "\n    \t", -1)
+
+	if !isZeroValue(fg, fg.DefValue) {
+		if _, ok := fg.Value.(*stringValue); ok {
+			// put quotes on the value
+			s += fmt.Sprintf(" (default <cyan>%q</>)", fg.DefValue)
+		} else {
+			s += fmt.Sprintf(" (default <cyan>%v</>)", fg.DefValue)
+		}
+	}
+
+	return s
+}
+
+// flagNamePrefix renders the long/short option name for fg, resolving
+// any shortcut registered via c.ShortcutFor. Returns ok=false if fg is
+// itself a registered shortcut, so ParseDefaults can skip it - it's
+// already shown as part of its long option's entry.
+func (c *Command) flagNamePrefix(fg *flag.Flag) (prefix string, ok bool) {
+	// is long option
+	if len(fg.Name) > 1 {
+		// find shortcut name
+		if sn := c.ShortName(fg.Name); sn != "" {
+			return fmt.Sprintf("  <info>-%s, --%s</>", sn, fg.Name), true
+		}
+		return fmt.Sprintf("      <info>--%s</>", fg.Name), true
+	}
+
+	// is short option, skip it
+	if c.isShortcut(fg.Name) {
+		return "", false
+	}
+
+	return fmt.Sprintf("  <info>-%s</>", fg.Name), true
+}
+
 // ShowHelp show command help info
 func (c *Command) ShowHelp(quit ...bool) {
-	commandHelp = color.ReplaceTag(commandHelp)
+	w := c.HelpWriter
+	if w == nil {
+		w = os.Stdout
+	}
 
-	// render and output help info
-	// RenderTplStr(os.Stdout, commandHelp, map[string]interface{}{
-	// render but not output
-	s := helper.RenderText(commandHelp, map[string]interface{}{
+	HelpPrinter(w, CommandHelpTemplate, map[string]interface{}{
 		"Cmd": c,
 		// parse options to string
 		"Options": color.String(c.ParseDefaults()),
 		// always upper first char
 		"UseFor": color.String(c.UseFor),
-	}, nil)
-
-	// parse help vars
-	fmt.Print(color.String(c.ReplaceVars(s)))
+		// group subcommands (if any) under their Category for display
+		"Categories": CommandCategories(c.Subcommands()),
+	})
 
 	if len(quit) > 0 && quit[0] {
 		Exit(OK)
@@ -190,58 +310,49 @@ func (c *Command) ShowHelp(quit ...bool) {
 //
 // NOTICE: the func is copied from package 'flag', func 'PrintDefaults'
 func (c *Command) ParseDefaults() string {
-	var s string
 	var ss []string
 
 	c.Flags.VisitAll(func(fg *flag.Flag) {
-		// is long option
-		if len(fg.Name) > 1 {
-			// find shortcut name
-			if sn := c.ShortName(fg.Name); sn != "" {
-				s = fmt.Sprintf("  <info>-%s, --%s</>", sn, fg.Name)
-			} else {
-				s = fmt.Sprintf("      <info>--%s</>", fg.Name)
-			}
-		} else {
-			// is short option, skip it
-			if c.isShortcut(fg.Name) {
-				return
-			}
-
-			s = fmt.Sprintf("  <info>-%s</>", fg.Name)
+		prefix, ok := c.flagNamePrefix(fg)
+		if !ok {
+			return
 		}
 
-		name, usage := flag.UnquoteUsage(fg)
-		// option value type
-		if len(name) > 0 {
-			s += fmt.Sprintf(" <magenta>%s</>", name)
-		}
-		// Boolean flags of one ASCII letter are so common we
-		// treat them specially, putting their usage on the same line.
-		if len(s) <= 4 { // space, space, '-', 'x'.
-			s += "\t"
+		// package flag puts the usage of one-letter boolean flags on the
+		// same line when the name part is at most 4 bytes. prefix carries
+		// color tags and is always longer than that, so every flag's usage
+		// goes on its own line.
+		if len(prefix) <= 4 { // space, space, '-', 'x'.
+			prefix += "\t"
 		} else {
-			// Four spaces before the tab triggers good alignment
-			// for both 4- and 8-space tab stops.
-			s += "\n    \t"
-		}
-		s += strings.Replace(strutil.UpperFirst(usage), "\n", "\n    \t", -1)
-
-		if !isZeroValue(fg, fg.DefValue) {
-			if _, ok := fg.Value.(*stringValue); ok {
-				// put quotes on the value
-				s += fmt.Sprintf(" (default <cyan>%q</>)", fg.DefValue)
-			} else {
-				s += fmt.Sprintf(" (default <cyan>%v</>)", fg.DefValue)
-			}
+			// Four spaces before the tab triggers good alignment for
+			// both 4- and 8-space tab stops.
+			prefix += "\n    \t"
 		}
 
-		ss = append(ss, s)
+		ss = append(ss, prefix+FlagStringer(fg))
 	})
 
 	return strings.Join(ss, "\n")
 }
 
+// typeNameOf returns a display name for flag.Value types defined in
+// gcli/flags, which flag.UnquoteUsage can't name on its own.
+func typeNameOf(val flag.Value) string {
+	switch val.(type) {
+	case *flags.StringSlice:
+		return "strings"
+	case *flags.IntSlice:
+		return "ints"
+	case *flags.Timestamp:
+		return "time"
+	case *flags.Enum:
+		return "enum"
+	default:
+		return "value"
+	}
+}
+
 // isZeroValue guesses whether the string represents the zero
 // value for a flag. It is not accurate but in practice works OK.
 // NOTICE: the func is copied from package 'flag', func 'isZeroValue'
@@ -0,0 +1,54 @@
+package gcli
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestCommand_ShowHelp_UsesHelpWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	c := &Command{Name: "greet", UseFor: "say hello to someone", alone: true}
+	c.HelpWriter = &buf
+
+	c.ShowHelp()
+
+	out := buf.String()
+	if !strings.Contains(out, "say hello to someone") {
+		t.Fatalf("ShowHelp() output = %q, want it to contain UseFor text", out)
+	}
+}
+
+func TestCommand_ParseDefaults_UsesFlagStringer(t *testing.T) {
+	c := &Command{Name: "greet", alone: true}
+	c.Flags.Init(c.Name, 0)
+	c.Flags.String("name", "world", "who to greet")
+
+	out := c.ParseDefaults()
+	if !strings.Contains(out, "--name") || !strings.Contains(out, "Who to greet") {
+		t.Fatalf("ParseDefaults() = %q, want it to describe --name", out)
+	}
+}
+
+func TestFlagStringer_OverridableWithDocumentedSignature(t *testing.T) {
+	old := FlagStringer
+	defer func() { FlagStringer = old }()
+
+	// FlagStringer's contract is func(*flag.Flag) string: a caller must
+	// be able to plug in a replacement with exactly that signature, with
+	// no *Command parameter.
+	FlagStringer = func(fg *flag.Flag) string {
+		return "CUSTOM:" + fg.Name
+	}
+
+	c := &Command{Name: "greet", alone: true}
+	c.Flags.Init(c.Name, 0)
+	c.Flags.String("name", "world", "who to greet")
+
+	out := c.ParseDefaults()
+	if !strings.Contains(out, "CUSTOM:name") {
+		t.Fatalf("ParseDefaults() = %q, want it to use the overridden FlagStringer", out)
+	}
+}
@@ -0,0 +1,179 @@
+package gcli
+
+import (
+	"flag"
+	"io"
+	"strings"
+)
+
+// CmdFunc is the handler signature for a Command's Func.
+type CmdFunc func(c *Command, args []string) error
+
+// Argument describes one positional argument for a Command, as collected
+// by Command.collectNamedArgs.
+type Argument struct {
+	Name        string
+	ShowName    string
+	Description string
+	Required    bool
+	// IsArray marks the last argument as variadic, collecting all
+	// remaining input args instead of just one.
+	IsArray bool
+	// Value holds the bound input: a string, or a []string when IsArray.
+	Value interface{}
+
+	index int
+}
+
+// NewArgument creates a named positional argument.
+func NewArgument(name, description string) *Argument {
+	return &Argument{Name: name, ShowName: name, Description: description}
+}
+
+// Command represents a single CLI command: its own flag set, positional
+// arguments, and (optionally) a tree of subcommands for when Func is nil.
+type Command struct {
+	// Name is the command's primary dispatch name.
+	Name string
+	// Aliases are alternative names that also dispatch to this command.
+	Aliases []string
+	// UseFor is the long description shown at the top of ShowHelp.
+	UseFor string
+	// Description is a short, one-line summary shown in command listings.
+	Description string
+	// Help is additional free-form help text, shown after examples.
+	Help string
+	// Examples holds example invocations, shown verbatim in help.
+	Examples string
+	// Category groups this command under a heading in grouped help
+	// output, see CommandCategories. Commands with no Category fall
+	// under the default "Commands" heading.
+	Category string
+	// Hidden suppresses this command from help output while keeping it
+	// dispatchable - useful for internal/debug commands.
+	Hidden bool
+	// Suggest enables "Did you mean ...?" hints for unknown subcommands
+	// dispatched under this command. See also App.Suggest.
+	Suggest bool
+
+	// Func is the command's handler. A nil Func means "this is a command
+	// group": Execute dispatches the first argument to a subcommand.
+	Func CmdFunc
+	// Flags holds the command's own flag definitions.
+	Flags flag.FlagSet
+	// SimpleHooks manages EvtBefore/EvtAfter/EvtError handlers.
+	SimpleHooks SimpleHooks
+	// HelpWriter is where ShowHelp writes rendered help text. Defaults to
+	// os.Stdout when nil.
+	HelpWriter io.Writer
+	// BashComplete, when set, supplies dynamic shell-completion candidates
+	// for this command's arguments (eg remote resource names). See
+	// gcli/completion.
+	BashComplete func(c *Command) []string
+
+	args   []*Argument
+	subs   []*Command
+	shorts map[string]string // long option name -> short name
+	alone  bool
+	app    *App
+}
+
+// NewCommand creates a standalone command with the given name, short
+// description, and handler func.
+func NewCommand(name, description string, fn CmdFunc) *Command {
+	return &Command{Name: name, Description: description, Func: fn, alone: true}
+}
+
+// AddArg registers arg as the next positional argument, in the order
+// arguments should appear on the command line.
+func (c *Command) AddArg(arg *Argument) *Command {
+	arg.index = len(c.args) + 1
+	c.args = append(c.args, arg)
+	return c
+}
+
+// Args returns the command's registered positional arguments.
+func (c *Command) Args() []*Argument { return c.args }
+
+// AddCommand registers sub as a subcommand of c.
+func (c *Command) AddCommand(sub *Command) *Command {
+	sub.alone = false
+	sub.app = c.app
+	c.subs = append(c.subs, sub)
+	return c
+}
+
+// Subcommands returns the command's registered subcommands.
+func (c *Command) Subcommands() []*Command { return c.subs }
+
+// findSubcommand looks up a direct subcommand by name or alias.
+func (c *Command) findSubcommand(name string) *Command {
+	for _, sub := range c.subs {
+		if sub.Name == name {
+			return sub
+		}
+		for _, alias := range sub.Aliases {
+			if alias == name {
+				return sub
+			}
+		}
+	}
+	return nil
+}
+
+// subcommandNames returns every dispatchable name (primary names and
+// aliases) across c's registered subcommands, for use as suggestion
+// candidates.
+func (c *Command) subcommandNames() (names []string) {
+	for _, sub := range c.subs {
+		names = append(names, sub.Name)
+		names = append(names, sub.Aliases...)
+	}
+	return
+}
+
+// NotAlone reports whether the command is running under an App or a
+// parent command, rather than standalone via Run/MustRun.
+func (c *Command) NotAlone() bool { return !c.alone }
+
+// AliasesString joins the command's aliases for display in help output.
+func (c *Command) AliasesString() string { return strings.Join(c.Aliases, ", ") }
+
+// ShortcutFor registers short as the shortcut for the long option name.
+func (c *Command) ShortcutFor(long, short string) *Command {
+	if c.shorts == nil {
+		c.shorts = make(map[string]string)
+	}
+	c.shorts[long] = short
+	return c
+}
+
+// ShortName returns the registered shortcut for a long option name, or ""
+// if none is registered.
+func (c *Command) ShortName(long string) string { return c.shorts[long] }
+
+// isShortcut reports whether name is already registered as some option's
+// shortcut, so ParseDefaults can skip rendering it as its own entry.
+func (c *Command) isShortcut(name string) bool {
+	for _, short := range c.shorts {
+		if short == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceVars expands help vars like `{$binName}` in s.
+func (c *Command) ReplaceVars(s string) string {
+	binName := c.Name
+	if c.app != nil && c.app.Name != "" {
+		binName = c.app.Name
+	}
+	return strings.NewReplacer("{$binName}", binName).Replace(s)
+}
+
+// initialize prepares the command's flag set for standalone running.
+func (c *Command) initialize() {
+	c.Flags.Init(c.Name, flag.ContinueOnError)
+	c.Flags.Usage = func() { c.ShowHelp(true) }
+}
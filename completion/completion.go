@@ -0,0 +1,204 @@
+// Package completion generates shell completion scripts (bash, zsh, fish,
+// powershell) for a gcli.App, and backs the runtime "complete" mode used
+// by those scripts to ask the binary itself for candidate completions.
+package completion
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gookit/gcli"
+)
+
+// Shell identifies a supported shell for completion script generation.
+type Shell string
+
+// supported shells
+const (
+	Bash       Shell = "bash"
+	Zsh        Shell = "zsh"
+	Fish       Shell = "fish"
+	PowerShell Shell = "powershell"
+)
+
+// Enable turns on shell-completion support for app: it sets
+// app.EnableBashCompletion and wires app.CompletionFunc to Complete,
+// evaluated against the app's currently registered commands.
+func Enable(app *gcli.App) {
+	app.EnableBashCompletion = true
+	app.CompletionFunc = func(prior []string, cur string) []string {
+		return Complete(app.Commands(), prior, cur)
+	}
+}
+
+// NewCommand builds the `completion bash|zsh|fish|powershell` command,
+// which prints a shell completion script for app to stdout. Register it
+// with app.AddCommand so it shows up like any other command.
+func NewCommand(app *gcli.App) *gcli.Command {
+	return gcli.NewCommand("completion", "Generate shell completion scripts", func(c *gcli.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("must specify a shell: bash, zsh, fish, powershell")
+		}
+
+		script, err := Generate(Shell(args[0]), app.Name, app.Commands())
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(script)
+		return nil
+	})
+}
+
+// Generate renders a completion script for shell, for an app named
+// binName with top-level commands cmds.
+func Generate(shell Shell, binName string, cmds []*gcli.Command) (string, error) {
+	names := commandNames(cmds)
+
+	switch shell {
+	case Bash:
+		return genBash(binName, names), nil
+	case Zsh:
+		return genZsh(binName, names), nil
+	case Fish:
+		return genFish(binName, cmds), nil
+	case PowerShell:
+		return genPowerShell(binName, names), nil
+	default:
+		return "", fmt.Errorf("completion: unsupported shell %q", shell)
+	}
+}
+
+func commandNames(cmds []*gcli.Command) []string {
+	var names []string
+	for _, c := range cmds {
+		names = append(names, c.Name)
+		names = append(names, c.Aliases...)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func genBash(binName string, names []string) string {
+	return fmt.Sprintf(`# bash completion for %[1]s
+_%[1]s_complete() {
+    local cur
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    COMPREPLY=( $(compgen -W "%[2]s" -- "$cur") )
+    if [[ "$cur" == -* ]]; then
+        COMPREPLY=( $(compgen -W "$(%[1]s "${COMP_WORDS[@]:1}" --generate-bash-completion)" -- "$cur") )
+    fi
+    return 0
+}
+complete -F _%[1]s_complete %[1]s
+`, binName, strings.Join(names, " "))
+}
+
+func genZsh(binName string, names []string) string {
+	return fmt.Sprintf(`#compdef %[1]s
+
+_%[1]s() {
+    local -a commands
+    commands=(%[2]s)
+    _describe 'command' commands
+}
+
+_%[1]s
+`, binName, strings.Join(names, " "))
+}
+
+func genFish(binName string, cmds []*gcli.Command) string {
+	var b strings.Builder
+	for _, c := range cmds {
+		fmt.Fprintf(&b, "complete -c %s -n \"__fish_use_subcommand\" -a %s", binName, c.Name)
+		if c.UseFor != "" {
+			fmt.Fprintf(&b, " -d %q", c.UseFor)
+		}
+		b.WriteByte('\n')
+
+		for _, alias := range c.Aliases {
+			fmt.Fprintf(&b, "complete -c %s -n \"__fish_use_subcommand\" -a %s\n", binName, alias)
+		}
+	}
+	return b.String()
+}
+
+func genPowerShell(binName string, names []string) string {
+	return fmt.Sprintf(`Register-ArgumentCompleter -Native -CommandName %[1]s -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+    @(%[2]s) | Where-Object { $_ -like "$wordToComplete*" } |
+        ForEach-Object { [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_) }
+}
+`, binName, strings.Join(quoteAll(names), ", "))
+}
+
+func quoteAll(ss []string) []string {
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = "'" + s + "'"
+	}
+	return out
+}
+
+// Complete returns candidate completions for the word being completed
+// (cur), given the command line words already entered (prior, not
+// including the binary name). This is what a binary invoked with the
+// hidden --generate-bash-completion sentinel should print, one candidate
+// per line.
+func Complete(cmds []*gcli.Command, prior []string, cur string) []string {
+	// no command chosen yet: complete on top-level command names/aliases
+	if len(prior) == 0 {
+		return filterPrefix(cur, commandNames(cmds))
+	}
+
+	cmd := findCommand(cmds, prior[0])
+	if cmd == nil {
+		return nil
+	}
+
+	// completing a flag name
+	if strings.HasPrefix(cur, "-") {
+		return filterPrefix(cur, longFlagNames(cmd))
+	}
+
+	// per-command dynamic completer, eg for remote resource names
+	if cmd.BashComplete != nil {
+		return filterPrefix(cur, cmd.BashComplete(cmd))
+	}
+
+	return nil
+}
+
+func findCommand(cmds []*gcli.Command, name string) *gcli.Command {
+	for _, c := range cmds {
+		if c.Name == name {
+			return c
+		}
+		for _, alias := range c.Aliases {
+			if alias == name {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+func longFlagNames(c *gcli.Command) (names []string) {
+	c.Flags.VisitAll(func(fg *flag.Flag) {
+		if len(fg.Name) > 1 {
+			names = append(names, "--"+fg.Name)
+		}
+	})
+	return
+}
+
+func filterPrefix(prefix string, candidates []string) (out []string) {
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package completion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/gcli"
+)
+
+func TestGenerate_Bash(t *testing.T) {
+	cmds := []*gcli.Command{{Name: "install"}, {Name: "list"}}
+
+	script, err := Generate(Bash, "myapp", cmds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(script, "complete -F _myapp_complete myapp") {
+		t.Errorf("bash script missing complete registration: %s", script)
+	}
+	if !strings.Contains(script, `"${COMP_WORDS[@]:1}"`) {
+		t.Errorf("bash script must forward the full word list, not just COMP_WORDS[1]: %s", script)
+	}
+}
+
+func TestGenerate_UnsupportedShell(t *testing.T) {
+	if _, err := Generate(Shell("csh"), "myapp", nil); err == nil {
+		t.Error("expected error for unsupported shell, got nil")
+	}
+}
+
+func TestComplete_TopLevel(t *testing.T) {
+	cmds := []*gcli.Command{{Name: "install"}, {Name: "init"}, {Name: "list"}}
+
+	got := Complete(cmds, nil, "in")
+	want := []string{"init", "install"}
+	if len(got) != len(want) {
+		t.Fatalf("Complete() = %v, want %v", got, want)
+	}
+}
+
+func TestEnable_WiresAppRun(t *testing.T) {
+	app := gcli.NewApp("myapp")
+	app.AddCommand(gcli.NewCommand("install", "install something", func(c *gcli.Command, args []string) error {
+		return nil
+	}))
+	Enable(app)
+
+	if !app.EnableBashCompletion {
+		t.Fatal("Enable() did not set app.EnableBashCompletion")
+	}
+
+	got := app.CompletionFunc(nil, "in")
+	if len(got) != 1 || got[0] != "install" {
+		t.Fatalf("CompletionFunc(nil, \"in\") = %v, want [install]", got)
+	}
+}
+
+func TestEnable_CompletesFlagNameForNestedWords(t *testing.T) {
+	// Simulates what the fixed bash script now sends: the full word list
+	// after the binary name ("${COMP_WORDS[@]:1}"), not just COMP_WORDS[1].
+	install := gcli.NewCommand("install", "install something", func(c *gcli.Command, args []string) error {
+		return nil
+	})
+	install.Flags.String("name", "", "package name")
+
+	app := gcli.NewApp("myapp")
+	app.AddCommand(install)
+	Enable(app)
+
+	var got []string
+	app.CompletionFunc = func(prior []string, cur string) []string {
+		got = Complete(app.Commands(), prior, cur)
+		return got
+	}
+
+	if err := app.Run([]string{"install", "--n", "--generate-bash-completion"}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "--name" {
+		t.Fatalf("completions = %v, want [--name]", got)
+	}
+}
+
+func TestNewCommand_GeneratesScript(t *testing.T) {
+	app := gcli.NewApp("myapp")
+	app.AddCommand(gcli.NewCommand("install", "install something", func(c *gcli.Command, args []string) error {
+		return nil
+	}))
+
+	cmd := NewCommand(app)
+	if cmd.Name != "completion" {
+		t.Fatalf("NewCommand().Name = %q, want completion", cmd.Name)
+	}
+
+	if err := cmd.Func(cmd, []string{"bash"}); err != nil {
+		t.Fatalf("completion command Func(bash) error = %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+// Package flags provides extra flag.Value implementations for use with
+// the stdlib flag.FlagSet (and thus gcli.Command), beyond what package
+// flag ships out of the box: repeatable slices, a parsed timestamp, a
+// generic wrapper for user types, and a validated enum.
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StringSlice is a flag.Value that collects repeated string flags
+// (`--tag a --tag b`) and also accepts a single flag value containing Sep
+// separated items (`--tag a,b`). The zero value is ready to use with a
+// default Sep of ",".
+type StringSlice struct {
+	Sep    string
+	values []string
+}
+
+// String implements flag.Value
+func (s *StringSlice) String() string {
+	if s == nil {
+		return ""
+	}
+	return "[" + strings.Join(s.values, ",") + "]"
+}
+
+// Set implements flag.Value. It is called once per occurrence of the flag.
+func (s *StringSlice) Set(val string) error {
+	sep := s.Sep
+	if sep == "" {
+		sep = ","
+	}
+
+	if strings.Contains(val, sep) {
+		s.values = append(s.values, strings.Split(val, sep)...)
+	} else {
+		s.values = append(s.values, val)
+	}
+	return nil
+}
+
+// Get returns the collected string values.
+func (s *StringSlice) Get() []string { return s.values }
+
+// IntSlice is a flag.Value that collects repeated int flags
+// (`--port 80 --port 443`) and also accepts a single flag value containing
+// Sep separated items (`--port 80,443`). The zero value is ready to use
+// with a default Sep of ",".
+type IntSlice struct {
+	Sep    string
+	values []int
+}
+
+// String implements flag.Value
+func (s *IntSlice) String() string {
+	if s == nil {
+		return ""
+	}
+
+	ss := make([]string, len(s.values))
+	for i, v := range s.values {
+		ss[i] = strconv.Itoa(v)
+	}
+	return "[" + strings.Join(ss, ",") + "]"
+}
+
+// Set implements flag.Value. It is called once per occurrence of the flag.
+func (s *IntSlice) Set(val string) error {
+	sep := s.Sep
+	if sep == "" {
+		sep = ","
+	}
+
+	for _, part := range strings.Split(val, sep) {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return fmt.Errorf("invalid int value %q: %w", part, err)
+		}
+		s.values = append(s.values, n)
+	}
+	return nil
+}
+
+// Get returns the collected int values.
+func (s *IntSlice) Get() []int { return s.values }
+
+// Timestamp is a flag.Value that parses a user-supplied time layout
+// (default time.RFC3339). Get returns nil until the flag has been set,
+// so callers can tell "not provided" apart from the zero time.
+type Timestamp struct {
+	Layout string
+	value  *time.Time
+}
+
+// String implements flag.Value
+func (t *Timestamp) String() string {
+	if t == nil || t.value == nil {
+		return ""
+	}
+	return t.value.Format(t.layout())
+}
+
+// Set implements flag.Value
+func (t *Timestamp) Set(val string) error {
+	parsed, err := time.Parse(t.layout(), val)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q: %w", val, err)
+	}
+
+	t.value = &parsed
+	return nil
+}
+
+// Get returns the parsed time, or nil if the flag was never set.
+func (t *Timestamp) Get() *time.Time { return t.value }
+
+func (t *Timestamp) layout() string {
+	if t.Layout == "" {
+		return time.RFC3339
+	}
+	return t.Layout
+}
+
+// Generic wraps any user type implementing flag.Value, so it can be
+// registered like the built-in helpers here. ParseDefaults has no type
+// label of its own for Generic and shows it as "value". In practice
+// Generic is just a thin passthrough - most users can register their
+// flag.Value directly, but some prefer the symmetry.
+type Generic struct {
+	Value interface {
+		Set(string) error
+		String() string
+	}
+}
+
+// String implements flag.Value
+func (g *Generic) String() string {
+	if g == nil || g.Value == nil {
+		return ""
+	}
+	return g.Value.String()
+}
+
+// Set implements flag.Value
+func (g *Generic) Set(val string) error { return g.Value.Set(val) }
+
+// Enum is a flag.Value that only accepts one of a fixed set of values.
+type Enum struct {
+	Allowed []string
+	value   string
+}
+
+// String implements flag.Value
+func (e *Enum) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.value
+}
+
+// Set implements flag.Value. It rejects any value not in e.Allowed.
+func (e *Enum) Set(val string) error {
+	for _, a := range e.Allowed {
+		if a == val {
+			e.value = val
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value %q, must be one of: %s", val, strings.Join(e.Allowed, ", "))
+}
+
+// Get returns the current enum value.
+func (e *Enum) Get() string { return e.value }
@@ -0,0 +1,101 @@
+package flags
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStringSlice(t *testing.T) {
+	var s StringSlice
+
+	if err := s.Set("a,b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("c"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"a", "b", "c"}
+	got := s.Get()
+	if len(got) != len(want) {
+		t.Fatalf("Get() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Fatalf("Get()[%d] = %q, want %q", i, got[i], v)
+		}
+	}
+
+	if s.String() != "[a,b,c]" {
+		t.Errorf("String() = %q, want [a,b,c]", s.String())
+	}
+}
+
+func TestIntSlice(t *testing.T) {
+	var s IntSlice
+
+	if err := s.Set("1,2"); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Get(); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("Get() = %v, want [1 2]", got)
+	}
+
+	if err := s.Set("nope"); err == nil {
+		t.Error("Set(nope) expected error, got nil")
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	var ts Timestamp
+
+	if got := ts.Get(); got != nil {
+		t.Fatalf("Get() before Set = %v, want nil", got)
+	}
+
+	if err := ts.Set("2024-01-02T15:04:05Z"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Get(); got == nil || got.Year() != 2024 {
+		t.Fatalf("Get() after Set = %v, want year 2024", got)
+	}
+}
+
+// upperValue is a tiny user flag.Value used to test Generic delegation.
+type upperValue struct{ s string }
+
+func (u *upperValue) Set(val string) error {
+	u.s = strings.ToUpper(val)
+	return nil
+}
+func (u *upperValue) String() string { return u.s }
+
+func TestGeneric(t *testing.T) {
+	inner := &upperValue{}
+	g := Generic{Value: inner}
+
+	if err := g.Set("hi"); err != nil {
+		t.Fatal(err)
+	}
+	if inner.s != "HI" {
+		t.Fatalf("inner.s = %q, want HI (Set should delegate to Value)", inner.s)
+	}
+	if g.String() != "HI" {
+		t.Fatalf("String() = %q, want HI (String should delegate to Value)", g.String())
+	}
+}
+
+func TestEnum(t *testing.T) {
+	e := Enum{Allowed: []string{"json", "yaml"}}
+
+	if err := e.Set("json"); err != nil {
+		t.Fatal(err)
+	}
+	if e.Get() != "json" {
+		t.Errorf("Get() = %q, want json", e.Get())
+	}
+
+	if err := e.Set("xml"); err == nil {
+		t.Error("Set(xml) expected error, got nil")
+	}
+}
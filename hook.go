@@ -0,0 +1,51 @@
+package gcli
+
+import "errors"
+
+// built in hook event name
+const (
+	EvtBefore = "before"
+	EvtAfter  = "after"
+	EvtError  = "error"
+)
+
+// HookFunc definition. data is the hook's own data, see Command.Fire
+//
+// NOTICE: if a handler bound to EvtBefore returns a non-nil error, the
+// command's Func is skipped entirely and EvtError fires with that error.
+type HookFunc func(c *Command, data interface{}) error
+
+// SimpleHooks a simple hook manager, allow bind multi handlers for an event.
+type SimpleHooks struct {
+	hooks map[string][]HookFunc
+}
+
+// On add hook handler for a hook event
+func (h *SimpleHooks) On(name string, handler HookFunc) {
+	if h.hooks == nil {
+		h.hooks = make(map[string][]HookFunc)
+	}
+
+	h.hooks[name] = append(h.hooks[name], handler)
+}
+
+// ClearHooks remove all registered hook handlers
+func (h *SimpleHooks) ClearHooks() {
+	h.hooks = nil
+}
+
+// Fire the event handlers by name, aggregating any returned errors.
+func (h *SimpleHooks) Fire(event string, c *Command, data interface{}) error {
+	var errs []error
+
+	for _, handler := range h.hooks[event] {
+		if err := handler(c, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
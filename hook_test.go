@@ -0,0 +1,60 @@
+package gcli
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSimpleHooks_Fire(t *testing.T) {
+	var hooks SimpleHooks
+	var called bool
+
+	hooks.On(EvtBefore, func(c *Command, data interface{}) error {
+		called = true
+		return nil
+	})
+
+	if err := hooks.Fire(EvtBefore, nil, nil); err != nil {
+		t.Fatalf("Fire() error = %v, want nil", err)
+	}
+	if !called {
+		t.Fatal("expected handler to be called")
+	}
+}
+
+func TestSimpleHooks_Fire_CollectsErrors(t *testing.T) {
+	var hooks SimpleHooks
+	err1 := errors.New("handler1 failed")
+	err2 := errors.New("handler2 failed")
+
+	hooks.On(EvtAfter, func(c *Command, data interface{}) error { return err1 })
+	hooks.On(EvtAfter, func(c *Command, data interface{}) error { return err2 })
+
+	err := hooks.Fire(EvtAfter, nil, nil)
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Fatalf("Fire() error = %v, want it to wrap both err1 and err2", err)
+	}
+}
+
+func TestCommand_Execute_BeforeErrorSkipsFunc(t *testing.T) {
+	var called bool
+	wantErr := errors.New("precondition failed")
+
+	c := &Command{
+		Name:  "deploy",
+		alone: true,
+		Func: func(c *Command, args []string) error {
+			called = true
+			return nil
+		},
+	}
+	c.On(EvtBefore, func(c *Command, data interface{}) error { return wantErr })
+
+	err := c.Execute(nil)
+	if called {
+		t.Error("Func was called despite the Before handler returning an error")
+	}
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Execute() error = %v, want it to wrap %v", err, wantErr)
+	}
+}
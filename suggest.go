@@ -0,0 +1,157 @@
+package gcli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+/*************************************************************
+ * "did you mean ...?" suggestions
+ *************************************************************/
+
+// suggestThreshold is the minimum Jaro-Winkler similarity for a candidate
+// to be offered as a suggestion.
+const suggestThreshold = 0.7
+
+// jaroSimilarity calculates the Jaro distance between two strings.
+func jaroSimilarity(s1, s2 string) float64 {
+	len1, len2 := len(s1), len(s2)
+	if len1 == 0 && len2 == 0 {
+		return 1
+	}
+	if len1 == 0 || len2 == 0 {
+		return 0
+	}
+
+	matchDistance := len1
+	if len2 > matchDistance {
+		matchDistance = len2
+	}
+	matchDistance = matchDistance/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	s1Matches := make([]bool, len1)
+	s2Matches := make([]bool, len2)
+
+	var matches int
+	for i := 0; i < len1; i++ {
+		start := i - matchDistance
+		if start < 0 {
+			start = 0
+		}
+		end := i + matchDistance + 1
+		if end > len2 {
+			end = len2
+		}
+
+		for j := start; j < end; j++ {
+			if s2Matches[j] || s1[i] != s2[j] {
+				continue
+			}
+			s1Matches[i] = true
+			s2Matches[j] = true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	var transpositions int
+	k := 0
+	for i := 0; i < len1; i++ {
+		if !s1Matches[i] {
+			continue
+		}
+		for !s2Matches[k] {
+			k++
+		}
+		if s1[i] != s2[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	return (m/float64(len1) + m/float64(len2) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// jaroWinkler calculates the Jaro-Winkler distance between two strings,
+// boosting the Jaro score for strings that share a common prefix.
+func jaroWinkler(s1, s2 string) float64 {
+	jaro := jaroSimilarity(s1, s2)
+
+	var prefix int
+	maxPrefix := 4
+	for ; prefix < len(s1) && prefix < len(s2) && prefix < maxPrefix; prefix++ {
+		if s1[prefix] != s2[prefix] {
+			break
+		}
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// suggestFor finds the candidate most similar to target, case-insensitively.
+// It returns an empty string if no candidate is above suggestThreshold.
+func suggestFor(target string, candidates []string) string {
+	target = strings.ToLower(target)
+
+	var best string
+	var bestScore float64
+	for _, cand := range candidates {
+		score := jaroWinkler(target, strings.ToLower(cand))
+		if score > bestScore {
+			bestScore = score
+			best = cand
+		}
+	}
+
+	if bestScore < suggestThreshold {
+		return ""
+	}
+	return best
+}
+
+// suggestMsg builds a "Did you mean ...?" message for target among
+// candidates, or "" if nothing is similar enough to suggest.
+func suggestMsg(target string, candidates []string) string {
+	best := suggestFor(target, candidates)
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf("Did you mean this?\n    %s", best)
+}
+
+// unknownFlagToken extracts the flag name from a stdlib flag.Parse error
+// message, eg `flag provided but not defined: -foo` -> "foo".
+func unknownFlagToken(errMsg string) string {
+	idx := strings.LastIndex(errMsg, " -")
+	if idx < 0 {
+		return ""
+	}
+	return strings.TrimLeft(errMsg[idx+1:], "-")
+}
+
+// longFlagNames collects the long option names registered on the command,
+// for use as suggestion candidates.
+func (c *Command) longFlagNames() (names []string) {
+	c.Flags.VisitAll(func(fg *flag.Flag) {
+		if len(fg.Name) > 1 {
+			names = append(names, fg.Name)
+		}
+	})
+	return
+}
+
+// suggestEnabled reports whether "Did you mean ...?" hints are switched
+// on for c, either directly via Command.Suggest or inherited from the
+// owning App.
+func (c *Command) suggestEnabled() bool {
+	return c.Suggest || (c.app != nil && c.app.Suggest)
+}
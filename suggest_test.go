@@ -0,0 +1,34 @@
+package gcli
+
+import "testing"
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		s1, s2 string
+		want   float64
+	}{
+		{"", "", 1},
+		{"martha", "marhta", 0.961},
+		{"dixon", "dicksonx", 0.813},
+		{"version", "verbose", 0.820},
+	}
+
+	for _, tt := range tests {
+		got := jaroWinkler(tt.s1, tt.s2)
+		if diff := got - tt.want; diff > 0.01 || diff < -0.01 {
+			t.Errorf("jaroWinkler(%q, %q) = %v, want ~%v", tt.s1, tt.s2, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestFor(t *testing.T) {
+	candidates := []string{"install", "init", "list", "version"}
+
+	if got := suggestFor("versoin", candidates); got != "version" {
+		t.Errorf("suggestFor(versoin) = %q, want version", got)
+	}
+
+	if got := suggestFor("zzzzzzz", candidates); got != "" {
+		t.Errorf("suggestFor(zzzzzzz) = %q, want empty", got)
+	}
+}